@@ -0,0 +1,155 @@
+// Package tui drives the ASCII animation demo as a Bubble Tea program.
+//
+// Each animation (dancer, cube, fire, rocket) owns one panel in a fixed
+// grid. Panels never print to stdout directly: they advance their own
+// frame counter on a tick and hand the result to View, which is the only
+// place that turns state into text. That keeps the four animations from
+// redrawing over each other the way the old goroutine/fmt.Println version
+// did.
+package tui
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const numPanels = 4
+
+const (
+	panelDancer = iota
+	panelCube
+	panelFire
+	panelRocket
+)
+
+var panelStyle = lipgloss.NewStyle().
+	Border(lipgloss.RoundedBorder()).
+	Padding(0, 1).
+	Width(20)
+
+// panel is one animation's state: its title, the frames it cycles
+// through, which frame it is currently on, and how often it advances.
+type panel struct {
+	title    string
+	frames   []string
+	frame    int
+	interval time.Duration
+	stars    int // extra sparkle count, only used by the rocket panel
+}
+
+// frameMsg advances a single panel by one frame.
+type frameMsg struct {
+	panel int
+}
+
+// Model is the Bubble Tea model for the whole demo: a fixed-size grid of
+// panels, one per animation.
+type Model struct {
+	panels [numPanels]panel
+	rng    *rand.Rand
+}
+
+// NewModel builds the initial grid with each animation's frame set. seed
+// seeds the rocket panel's star count via a PCG source, so the same seed
+// always reproduces the same starfield.
+func NewModel(seed uint64) Model {
+	return Model{
+		rng: rand.New(rand.NewPCG(seed, seed)),
+		panels: [numPanels]panel{
+			panelDancer: {
+				title:    "Dancing Person",
+				interval: 300 * time.Millisecond,
+				frames: []string{
+					"\n     o\n    /|\\\n    / \\     ♪",
+					"\n     o\n    /|\\\n    / \\     ♫",
+					"\n    \\o/\n     |\n    / \\    ♪",
+					"\n     o\n    /|\\\n    / \\     ♬",
+				},
+			},
+			panelCube: {
+				title:    "Rotating Cube",
+				interval: 250 * time.Millisecond,
+				frames: []string{
+					"\n    ╔═════╗\n   ║░░░░░║\n   ║░░░░░║\n   ╚═════╝",
+					"\n    ┌─────┐\n   ╱░░░░░╲\n  ╱░░░░░░╲\n  ╲░░░░░░╱\n   ╲░░░░░╱\n    └─────┘",
+					"\n      ╔═╗\n     ╔═══╚═╗\n     ║░░░░║\n     ╚═╗╔═╝\n      ╚═╝",
+					"\n    ┌─────┐\n   ╲░░░░░╱\n    ╲░░░░╱\n     ╲░░░╱\n      ╲░╱\n       ──",
+				},
+			},
+			panelFire: {
+				title:    "Fire Animation",
+				interval: 200 * time.Millisecond,
+				frames: []string{
+					"\n       🔥\n      🔥🔥\n     🔥🔥🔥\n    🔥🔥🔥🔥\n   🔥🔥🔥🔥🔥",
+					"\n         ^^^\n        ^^^^^\n       ^^^^^^^\n      ^^^^^^^^^\n     ^^^^^^^^^^^",
+					"\n        /\\_/\\\n       ( o.o )\n        > ^ <  ",
+					"\n     ╱╲╱╲╱╲\n    ╱╲╱╲╱╲╱╲\n   ╱╲╱╲╱╲╱╲╱╲",
+				},
+			},
+			panelRocket: {
+				title:    "Rocket Launch",
+				interval: 400 * time.Millisecond,
+				stars:    1,
+				frames: []string{
+					"\n     ^\n    / \\\n   |   |\n   |   |\n   |   |\n  /|   |\\\n / |___| \\\n   |||||\n   |||||",
+				},
+			},
+		},
+	}
+}
+
+// Init kicks off one ticking tea.Cmd per panel so they all animate
+// independently of one another.
+func (m Model) Init() tea.Cmd {
+	cmds := make([]tea.Cmd, numPanels)
+	for i, p := range m.panels {
+		cmds[i] = tick(i, p.interval)
+	}
+	return tea.Batch(cmds...)
+}
+
+func tick(panel int, interval time.Duration) tea.Cmd {
+	return tea.Tick(interval, func(time.Time) tea.Msg {
+		return frameMsg{panel: panel}
+	})
+}
+
+// Update routes each panel's frame-update message to that panel and
+// reschedules its next tick.
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q", "esc":
+			return m, tea.Quit
+		}
+	case frameMsg:
+		p := &m.panels[msg.panel]
+		p.frame++
+		if msg.panel == panelRocket {
+			p.stars = m.rng.IntN(3) + 1
+		}
+		return m, tick(msg.panel, p.interval)
+	}
+	return m, nil
+}
+
+// View lays the four panels out side-by-side so none of them ever
+// overwrite each other's output.
+func (m Model) View() string {
+	rendered := make([]string, numPanels)
+	for i, p := range m.panels {
+		body := p.frames[p.frame%len(p.frames)]
+		if i == panelRocket {
+			for k := 0; k < p.stars; k++ {
+				body += " ✨"
+			}
+		}
+		rendered[i] = panelStyle.Render(fmt.Sprintf("%s\n%s", p.title, body))
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, rendered...) + "\n\nq / ctrl+c to quit\n"
+}
@@ -1,172 +1,63 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
 	"fmt"
-	"math/rand"
-	"sync"
-	"time"
-)
-
-func main() {
-	fmt.Println("ðŸš€ Go ASCII Art Demo with Goroutines ðŸš€")
-	fmt.Println("==========================================")
-
-	var wg sync.WaitGroup
-
-	// // Launch multiple goroutines with different ASCII art
-	wg.Add(4)
-
-	// Goroutine 1: Dancing person
-	go func() {
-		defer wg.Done()
-		showDancingPerson()
-	}()
-
-	// Goroutine 2: Rotating cube
-	go func() {
-		defer wg.Done()
-		showRotatingCube()
-	}()
-
-	// Goroutine 3: Fire animation
-	go func() {
-		defer wg.Done()
-		showFireAnimation()
-	}()
-
-	// Goroutine 4: Space rocket
-	go func() {
-		defer wg.Done()
-		showRocket()
-	}()
-
-	// // Wait for all goroutines to finish
-	wg.Wait()
-
-	fmt.Println("\nâœ¨ All animations completed! âœ¨")
-}
+	"math/rand/v2"
+	"os"
+	"os/signal"
+	"syscall"
 
-func showDancingPerson() {
-	frames := []string{
-		`
-     o
-    /|\
-    / \     â™ª`,
-		`
-     o
-    /|\
-    / \     â™«`,
-		`
-    \o/
-     |
-    / \    â™ª`,
-		`
-     o
-    /|\
-    / \     â™¬`,
-	}
+	tea "github.com/charmbracelet/bubbletea"
 
-	for i := 0; i < 8; i++ {
-		fmt.Printf("\nDancing Person:\n%s\n", frames[i%len(frames)])
-		time.Sleep(300 * time.Millisecond)
-	}
-}
+	"github.com/DragonOS-Community/DragonOS/user/apps/go_demo/internal/tui"
+)
 
-func showRotatingCube() {
-	frames := []string{
-		`
-    â•”â•â•â•â•â•â•—
-   â•‘â–‘â–‘â–‘â–‘â–‘â•‘
-   â•‘â–‘â–‘â–‘â–‘â–‘â•‘
-   â•šâ•â•â•â•â•â•`,
-		`
-    â”Œâ”€â”€â”€â”€â”€â”
-   â•±â–‘â–‘â–‘â–‘â–‘â•²
-  â•±â–‘â–‘â–‘â–‘â–‘â–‘â•²
-  â•²â–‘â–‘â–‘â–‘â–‘â–‘â•±
-   â•²â–‘â–‘â–‘â–‘â–‘â•±
-    â””â”€â”€â”€â”€â”€â”˜`,
-		`
-      â•”â•â•—
-     â•”â•â•â•šâ•â•—
-     â•‘â–‘â–‘â–‘â–‘â•‘
-     â•šâ•â•—â•”â•â•
-      â•šâ•â•`,
-		`
-    â”Œâ”€â”€â”€â”€â”€â”
-   â•²â–‘â–‘â–‘â–‘â–‘â•±
-    â•²â–‘â–‘â–‘â–‘â•±
-     â•²â–‘â–‘â–‘â•±
-      â•²â–‘â–‘â•±
-       â”€â”€`,
-	}
+func main() {
+	seedFlag := flag.Uint64("seed", 0, "seed for the rocket panel's starfield; 0 picks a random seed")
+	modeFlag := flag.String("mode", "grid", "rendering mode: stacked (old scrolling output), grid (Bubble Tea panels), serial (channel-multiplexed renderer)")
+	flag.Parse()
 
-	for i := 0; i < 10; i++ {
-		fmt.Printf("\nRotating Cube:\n%s\n", frames[i%len(frames)])
-		time.Sleep(250 * time.Millisecond)
+	seed := *seedFlag
+	if seed == 0 {
+		seed = rand.Uint64()
 	}
-}
 
-func showFireAnimation() {
-	frames := []string{
-		`
-       ðŸ”¥
-      ðŸ”¥ðŸ”¥
-     ðŸ”¥ðŸ”¥ðŸ”¥
-    ðŸ”¥ðŸ”¥ðŸ”¥ðŸ”¥
-   ðŸ”¥ðŸ”¥ðŸ”¥ðŸ”¥ðŸ”¥`,
-		`
-         ^^^
-        ^^^^^
-       ^^^^^^^
-      ^^^^^^^^^
-     ^^^^^^^^^^^`,
-		`
-        /\_/\
-       ( o.o )
-        > ^ <  `,
-		`
-     â•±â•²â•±â•²â•±â•²
-    â•±â•²â•±â•²â•±â•²â•±â•²
-   â•±â•²â•±â•²â•±â•²â•±â•²â•±â•²`,
-	}
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
 
-	for i := 0; i < 12; i++ {
-		fmt.Printf("\nFire Animation:\n%s\n", frames[i%len(frames)])
-		time.Sleep(200 * time.Millisecond)
+	switch *modeFlag {
+	case "stacked":
+		runStacked(ctx, seed)
+	case "serial":
+		runSerial(ctx, seed)
+	case "grid":
+		runGrid(ctx, seed)
+	default:
+		fmt.Fprintf(os.Stderr, "unknown -mode %q (want stacked, grid, or serial)\n", *modeFlag)
+		os.Exit(1)
 	}
 }
 
-func showRocket() {
-	rocket := []string{
-		`
-     ^
-    / \\
-   |   |
-   |   |
-   |   |
-  /|   |\\
- / |___| \\
-   |||||
-   |||||`,
-	}
-
-	for i := 0; i < 15; i++ {
-		// Clear screen and show rocket at different positions
-		fmt.Printf("\nRocket Launch:\n")
-		for j := 0; j < i%5; j++ {
-			fmt.Println()
-		}
-		fmt.Print(rocket[0])
-
-		// Add stars
-		stars := rand.Intn(3) + 1
-		for k := 0; k < stars; k++ {
-			fmt.Printf(" %s", "âœ¨")
-		}
+func runGrid(ctx context.Context, seed uint64) {
+	fmt.Println("🚀 Go ASCII Art Demo with Goroutines 🚀")
+	fmt.Println("==========================================")
 
-		time.Sleep(400 * time.Millisecond)
+	// NotifyContext disables the default disposition for these signals
+	// process-wide, so the program must observe ctx itself to still exit
+	// on SIGTERM/SIGINT (Bubble Tea's own ctrl+c key handling only covers
+	// an interactive keypress, not a signal sent to the process).
+	_, err := tea.NewProgram(tui.NewModel(seed), tea.WithContext(ctx)).Run()
+	if errors.Is(err, tea.ErrProgramKilled) {
+		// ctx was cancelled: this is the expected Ctrl-C/SIGTERM shutdown
+		// path, not a failure.
+		fmt.Println("👋 Interrupted, shutting down cleanly.")
+		return
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error running demo:", err)
+		os.Exit(1)
 	}
 }
-
-// No need for explicit seeding in Go 1.21+ - global rand is automatically seeded
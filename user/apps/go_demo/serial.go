@@ -0,0 +1,217 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Frame is one panel's redraw, destined for the single goroutine that
+// owns stdout.
+type Frame struct {
+	Panel int
+	Body  string
+}
+
+// Panel row/column layout for the serial renderer: each panel gets a
+// fixed region of the terminal, addressed by ANSI cursor positioning, so
+// frames from different animations never interleave mid-line.
+const (
+	serialPanelDancer = iota
+	serialPanelCube
+	serialPanelFire
+	serialPanelRocket
+	serialPanelCount
+)
+
+// serialPanelHeight is sized to the tallest panel's output, the rocket
+// (title line + 9 ASCII rows, the last of which carries the star
+// sparkle), so no panel's bottom rows get truncated.
+const serialPanelHeight = 10
+
+// runSerial multiplexes the four animations over a single chan Frame and
+// lets one dedicated renderer goroutine own stdout. That turns the demo
+// from a data race into a correct producer/consumer example: many
+// producers, one consumer.
+//
+// ctx is threaded through to each producer so Ctrl-C stops them cleanly;
+// the renderer always drains whatever frames are already in flight before
+// the farewell message is printed.
+func runSerial(ctx context.Context, seed uint64) {
+	rng := rand.New(rand.NewPCG(seed, seed))
+
+	frames := make(chan Frame)
+	done := make(chan struct{})
+	go renderFrames(frames, done)
+
+	var wg sync.WaitGroup
+	wg.Add(4)
+
+	go func() {
+		defer wg.Done()
+		serialDancingPerson(ctx, frames)
+	}()
+	go func() {
+		defer wg.Done()
+		serialRotatingCube(ctx, frames)
+	}()
+	go func() {
+		defer wg.Done()
+		serialFireAnimation(ctx, frames)
+	}()
+	go func() {
+		defer wg.Done()
+		serialRocket(ctx, frames, rng)
+	}()
+
+	wg.Wait()
+	close(frames)
+	<-done
+
+	fmt.Print("\x1b[H\x1b[2J")
+	if ctx.Err() != nil {
+		fmt.Println("👋 Interrupted, shutting down cleanly.")
+		return
+	}
+	fmt.Println("✨ All animations completed! ✨")
+}
+
+// renderFrames is the sole writer of stdout: it reads Frames off the
+// channel and repaints just that panel's region in place, clearing only
+// the lines that belong to that panel so the other three panels' most
+// recent frames are left untouched.
+func renderFrames(frames <-chan Frame, done chan<- struct{}) {
+	defer close(done)
+
+	fmt.Print("\x1b[2J") // clear screen once up front
+	for f := range frames {
+		lines := strings.Split(f.Body, "\n")
+		base := f.Panel*serialPanelHeight + 1
+
+		var out strings.Builder
+		for i := 0; i < serialPanelHeight; i++ {
+			fmt.Fprintf(&out, "\x1b[%d;1H\x1b[K", base+i)
+			if i < len(lines) {
+				out.WriteString(lines[i])
+			}
+		}
+		fmt.Print(out.String())
+	}
+}
+
+func serialDancingPerson(ctx context.Context, frames chan<- Frame) {
+	body := []string{
+		`Dancing Person:
+     o
+    /|\
+    / \     ♪`,
+		`Dancing Person:
+     o
+    /|\
+    / \     ♫`,
+		`Dancing Person:
+    \o/
+     |
+    / \    ♪`,
+		`Dancing Person:
+     o
+    /|\
+    / \     ♬`,
+	}
+
+	for i := 0; i < 8 && ctx.Err() == nil; i++ {
+		frames <- Frame{Panel: serialPanelDancer, Body: body[i%len(body)]}
+		sleep(ctx, 300*time.Millisecond)
+	}
+}
+
+func serialRotatingCube(ctx context.Context, frames chan<- Frame) {
+	body := []string{
+		`Rotating Cube:
+    ╔═════╗
+   ║░░░░░║
+   ║░░░░░║
+   ╚═════╝`,
+		`Rotating Cube:
+    ┌─────┐
+   ╱░░░░░╲
+  ╱░░░░░░╲
+  ╲░░░░░░╱
+   ╲░░░░░╱
+    └─────┘`,
+		`Rotating Cube:
+      ╔═╗
+     ╔═══╚═╗
+     ║░░░░║
+     ╚═╗╔═╝
+      ╚═╝`,
+		`Rotating Cube:
+    ┌─────┐
+   ╲░░░░░╱
+    ╲░░░░╱
+     ╲░░░╱
+      ╲░╱
+       ──`,
+	}
+
+	for i := 0; i < 10 && ctx.Err() == nil; i++ {
+		frames <- Frame{Panel: serialPanelCube, Body: body[i%len(body)]}
+		sleep(ctx, 250*time.Millisecond)
+	}
+}
+
+func serialFireAnimation(ctx context.Context, frames chan<- Frame) {
+	body := []string{
+		`Fire Animation:
+       🔥
+      🔥🔥
+     🔥🔥🔥
+    🔥🔥🔥🔥
+   🔥🔥🔥🔥🔥`,
+		`Fire Animation:
+         ^^^
+        ^^^^^
+       ^^^^^^^
+      ^^^^^^^^^
+     ^^^^^^^^^^^`,
+		`Fire Animation:
+        /\_/\
+       ( o.o )
+        > ^ <  `,
+		`Fire Animation:
+     ╱╲╱╲╱╲
+    ╱╲╱╲╱╲╱╲
+   ╱╲╱╲╱╲╱╲╱╲`,
+	}
+
+	for i := 0; i < 12 && ctx.Err() == nil; i++ {
+		frames <- Frame{Panel: serialPanelFire, Body: body[i%len(body)]}
+		sleep(ctx, 200*time.Millisecond)
+	}
+}
+
+func serialRocket(ctx context.Context, frames chan<- Frame, rng *rand.Rand) {
+	rocket := `
+     ^
+    / \
+   |   |
+   |   |
+   |   |
+  /|   |\
+ / |___| \
+   |||||
+   |||||`
+
+	for i := 0; i < 15 && ctx.Err() == nil; i++ {
+		stars := rng.IntN(3) + 1
+		sparkle := ""
+		for k := 0; k < stars; k++ {
+			sparkle += " ✨"
+		}
+		frames <- Frame{Panel: serialPanelRocket, Body: "Rocket Launch:" + rocket + sparkle}
+		sleep(ctx, 400*time.Millisecond)
+	}
+}
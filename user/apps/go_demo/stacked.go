@@ -0,0 +1,179 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+// runStacked reproduces the demo's original behavior: four goroutines
+// each fmt.Printf their own animation straight to stdout. Frames from the
+// dancer, cube, fire, and rocket panels interleave mid-line, so this mode
+// exists only to keep the old scrolling output available via -mode=stacked.
+//
+// ctx is threaded through to each animation so Ctrl-C stops them cleanly
+// instead of leaving the terminal mid-frame.
+func runStacked(ctx context.Context, seed uint64) {
+	fmt.Println("🚀 Go ASCII Art Demo with Goroutines 🚀")
+	fmt.Println("==========================================")
+
+	rng := rand.New(rand.NewPCG(seed, seed))
+
+	var wg sync.WaitGroup
+	wg.Add(4)
+
+	go func() {
+		defer wg.Done()
+		showDancingPerson(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		showRotatingCube(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		showFireAnimation(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		showRocket(ctx, rng)
+	}()
+
+	wg.Wait()
+
+	if ctx.Err() != nil {
+		fmt.Println("\n👋 Interrupted, shutting down cleanly.")
+		return
+	}
+	fmt.Println("\n✨ All animations completed! ✨")
+}
+
+// sleep waits for d, or returns early with ctx.Err() != nil if ctx is
+// cancelled first.
+func sleep(ctx context.Context, d time.Duration) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(d):
+	}
+}
+
+func showDancingPerson(ctx context.Context) {
+	frames := []string{
+		`
+     o
+    /|\
+    / \     ♪`,
+		`
+     o
+    /|\
+    / \     ♫`,
+		`
+    \o/
+     |
+    / \    ♪`,
+		`
+     o
+    /|\
+    / \     ♬`,
+	}
+
+	for i := 0; i < 8 && ctx.Err() == nil; i++ {
+		fmt.Printf("\nDancing Person:\n%s\n", frames[i%len(frames)])
+		sleep(ctx, 300*time.Millisecond)
+	}
+}
+
+func showRotatingCube(ctx context.Context) {
+	frames := []string{
+		`
+    ╔═════╗
+   ║░░░░░║
+   ║░░░░░║
+   ╚═════╝`,
+		`
+    ┌─────┐
+   ╱░░░░░╲
+  ╱░░░░░░╲
+  ╲░░░░░░╱
+   ╲░░░░░╱
+    └─────┘`,
+		`
+      ╔═╗
+     ╔═══╚═╗
+     ║░░░░║
+     ╚═╗╔═╝
+      ╚═╝`,
+		`
+    ┌─────┐
+   ╲░░░░░╱
+    ╲░░░░╱
+     ╲░░░╱
+      ╲░╱
+       ──`,
+	}
+
+	for i := 0; i < 10 && ctx.Err() == nil; i++ {
+		fmt.Printf("\nRotating Cube:\n%s\n", frames[i%len(frames)])
+		sleep(ctx, 250*time.Millisecond)
+	}
+}
+
+func showFireAnimation(ctx context.Context) {
+	frames := []string{
+		`
+       🔥
+      🔥🔥
+     🔥🔥🔥
+    🔥🔥🔥🔥
+   🔥🔥🔥🔥🔥`,
+		`
+         ^^^
+        ^^^^^
+       ^^^^^^^
+      ^^^^^^^^^
+     ^^^^^^^^^^^`,
+		`
+        /\_/\
+       ( o.o )
+        > ^ <  `,
+		`
+     ╱╲╱╲╱╲
+    ╱╲╱╲╱╲╱╲
+   ╱╲╱╲╱╲╱╲╱╲`,
+	}
+
+	for i := 0; i < 12 && ctx.Err() == nil; i++ {
+		fmt.Printf("\nFire Animation:\n%s\n", frames[i%len(frames)])
+		sleep(ctx, 200*time.Millisecond)
+	}
+}
+
+func showRocket(ctx context.Context, rng *rand.Rand) {
+	rocket := `
+     ^
+    / \
+   |   |
+   |   |
+   |   |
+  /|   |\
+ / |___| \
+   |||||
+   |||||`
+
+	for i := 0; i < 15 && ctx.Err() == nil; i++ {
+		fmt.Printf("\nRocket Launch:\n")
+		for j := 0; j < i%5; j++ {
+			fmt.Println()
+		}
+		fmt.Print(rocket)
+
+		stars := rng.IntN(3) + 1
+		for k := 0; k < stars; k++ {
+			fmt.Printf(" %s", "✨")
+		}
+
+		sleep(ctx, 400*time.Millisecond)
+	}
+}